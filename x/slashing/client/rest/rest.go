@@ -0,0 +1,27 @@
+package rest
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/gorilla/mux"
+)
+
+// RegisterRoutes registers the slashing module REST routes, including the
+// side-chain (BSC) unjail, evidence-submission and signing-info endpoints.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	r.HandleFunc(
+		"/slashing/side_chain/{sideChainId}/validators/{validatorAddr}/unjail",
+		sideChainUnjailRequestHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/slashing/side_chain/{sideChainId}/evidence",
+		sideChainSubmitEvidenceRequestHandlerFn(cdc, cliCtx),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/slashing/side_chain/{sideChainId}/validators/{validatorAddr}/signing_info",
+		sideChainSigningInfoHandlerFn(cdc, cliCtx),
+	).Methods("GET")
+}
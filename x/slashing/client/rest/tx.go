@@ -0,0 +1,111 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/rest"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+
+	"github.com/gorilla/mux"
+)
+
+// SideChainUnjailReq is the request body for unjailing a side-chain
+// validator.
+type SideChainUnjailReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+}
+
+func sideChainUnjailRequestHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bech32validator := vars["validatorAddr"]
+
+		sideChainId, err := slashing.SideChainIdFromText(vars["sideChainId"])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req SideChainUnjailReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		validatorAddr, err := sdk.ValAddressFromBech32(bech32validator)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := slashing.NewMsgSideChainUnjail(sideChainId, validatorAddr)
+		if err := msg.ValidateBasic(); err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.CompleteAndBroadcastTxREST(w, r, cliCtx, baseReq, []sdk.Msg{msg}, cdc)
+	}
+}
+
+// BscHeader carries one of the two conflicting consensus headers that make
+// up a BSC double-sign evidence submission.
+type BscHeader struct {
+	ConsensusHeader []byte `json:"consensus_header"`
+}
+
+// SideChainSubmitEvidenceReq is the request body for submitting BSC
+// double-sign evidence.
+type SideChainSubmitEvidenceReq struct {
+	Req     rest.BaseReq `json:"base_req"`
+	Headers [2]BscHeader `json:"headers"`
+}
+
+func sideChainSubmitEvidenceRequestHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		sideChainId, err := slashing.SideChainIdFromText(vars["sideChainId"])
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req SideChainSubmitEvidenceReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		baseReq := req.Req.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		submitter, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := slashing.NewMsgBscSubmitEvidence(
+			sideChainId,
+			submitter,
+			req.Headers[0].ConsensusHeader,
+			req.Headers[1].ConsensusHeader,
+		)
+		if err := msg.ValidateBasic(); err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.CompleteAndBroadcastTxREST(w, r, cliCtx, baseReq, []sdk.Msg{msg}, cdc)
+	}
+}
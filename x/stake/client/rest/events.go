@@ -0,0 +1,155 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// stakingEventTypes are the Tendermint event types a staking-aware frontend
+// cares about: the staking lifecycle events plus the slashing/jail events
+// that can affect a delegator's bonded validators.
+var stakingEventTypes = []string{
+	"create_validator",
+	"edit_validator",
+	"delegate",
+	"begin_unbonding",
+	"begin_redelegate",
+	"complete_unbonding",
+	"slash",
+	"unjail",
+}
+
+var eventsUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	// the LCD is queried from browsers on a different origin than the node
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// StakeEvent is the JSON frame pushed to a /stake/events subscriber: the
+// event's action type, block height, tx hash and tags as a flat object. It
+// does not have the shape of the /stake/delegators/{addr}/txs response,
+// which wraps full tx infos in an {items,total,page,limit} envelope.
+type StakeEvent struct {
+	Type   string            `json:"type"`
+	Height int64             `json:"height"`
+	TxHash string            `json:"tx_hash,omitempty"`
+	Tags   map[string]string `json:"tags"`
+}
+
+// eventsHandlerFn upgrades the request to a WebSocket and streams staking
+// and slashing events matching the caller's ?delegator=, ?validator= and
+// ?type= filters until the client disconnects.
+func eventsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		eventTypes := stakingEventTypes
+		if rawTypes := strings.TrimSpace(q.Get("type")); len(rawTypes) > 0 {
+			eventTypes = strings.Split(rawTypes, ",")
+		}
+
+		query := buildEventsQuery(eventTypes, q.Get("delegator"), q.Get("validator"))
+
+		conn, err := eventsUpgrader.Upgrade(w, r, nil)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		defer conn.Close()
+
+		subscriber := fmt.Sprintf("stake-events-%s", r.RemoteAddr)
+		out, err := cliCtx.Client.Subscribe(subscriber, query)
+		if err != nil {
+			conn.WriteJSON(map[string]string{"error": err.Error()})
+			return
+		}
+		defer cliCtx.Client.Unsubscribe(subscriber, query)
+
+		// A WebSocket only learns about a client-initiated close (tab
+		// closed, app backgrounded) through a failed read, so this
+		// connection needs its own read pump even though the handler never
+		// expects incoming messages. Without it, a client that disappears
+		// without a TCP reset would leave this goroutine, the Tendermint
+		// pubsub subscription and the socket itself open forever.
+		closed := make(chan struct{})
+		go func() {
+			defer close(closed)
+			for {
+				if _, _, err := conn.ReadMessage(); err != nil {
+					return
+				}
+			}
+		}()
+
+		for {
+			select {
+			case <-closed:
+				return
+			case msg, ok := <-out:
+				if !ok {
+					return
+				}
+				event, ok := msg.(tmtypes.TMEventData)
+				if !ok {
+					continue
+				}
+				frame, err := toStakeEvent(event)
+				if err != nil {
+					continue
+				}
+				if err := conn.WriteJSON(frame); err != nil {
+					return
+				}
+			}
+		}
+	}
+}
+
+// buildEventsQuery translates the REST filters into the Tendermint
+// tag-based subscription query string accepted by cliCtx.Client.Subscribe.
+func buildEventsQuery(eventTypes []string, delegator, validator string) string {
+	clauses := make([]string, 0, len(eventTypes)+2)
+	typeClauses := make([]string, 0, len(eventTypes))
+	for _, t := range eventTypes {
+		typeClauses = append(typeClauses, fmt.Sprintf("action='%s'", t))
+	}
+	if len(typeClauses) > 0 {
+		clauses = append(clauses, "("+strings.Join(typeClauses, " OR ")+")")
+	}
+	if len(delegator) > 0 {
+		clauses = append(clauses, fmt.Sprintf("delegator='%s'", delegator))
+	}
+	if len(validator) > 0 {
+		clauses = append(clauses, fmt.Sprintf("validator='%s'", validator))
+	}
+	return strings.Join(clauses, " AND ")
+}
+
+// toStakeEvent converts a raw Tendermint event into the StakeEvent frame
+// shape sent to WebSocket subscribers.
+func toStakeEvent(event tmtypes.TMEventData) (StakeEvent, error) {
+	switch evt := event.(type) {
+	case tmtypes.EventDataTx:
+		tags := make(map[string]string, len(evt.Result.Tags))
+		for _, tag := range evt.Result.Tags {
+			tags[string(tag.Key)] = string(tag.Value)
+		}
+		return StakeEvent{
+			Type:   tags["action"],
+			Height: evt.Height,
+			TxHash: fmt.Sprintf("%X", tmtypes.Tx(evt.Tx).Hash()),
+			Tags:   tags,
+		}, nil
+	default:
+		return StakeEvent{}, fmt.Errorf("unsupported event type %T", event)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/querier"
 	"github.com/cosmos/cosmos-sdk/x/stake/types"
 
 	"github.com/gorilla/mux"
@@ -18,6 +19,12 @@ const storeName = "stake"
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
 
+	// Get an aggregated portfolio view of a delegator
+	r.HandleFunc(
+		"/stake/delegators/{delegatorAddr}",
+		delegatorSummaryHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
 	// Get all delegations from a delegator
 	r.HandleFunc(
 		"/stake/delegators/{delegatorAddr}/delegations",
@@ -102,21 +109,124 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 		paramsHandlerFn(cliCtx, cdc),
 	).Methods("GET")
 
+	// Subscribe to a filtered stream of staking and slashing events
+	r.HandleFunc(
+		"/stake/events",
+		eventsHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
+}
+
+// paginationParamsFromRequest reads ?page=, ?limit=, ?status= and ?sort=
+// off the request, defaulting page/limit when they are missing.
+func paginationParamsFromRequest(r *http.Request) (querier.QueryPaginationParams, error) {
+	q := r.URL.Query()
+	return querier.ParseQueryPaginationParams(
+		q.Get("page"), q.Get("limit"), q.Get("status"), q.Get("sort"),
+	)
+}
+
+// HTTP request handler to query an aggregated delegator portfolio: their
+// delegations, unbonding delegations, redelegations, bonded validators and
+// total bonded/unbonding amounts in a single round trip.
+func delegatorSummaryHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bech32delegatorAddr := vars["delegatorAddr"]
+
+		delegatorAddr, err := sdk.AccAddressFromBech32(bech32delegatorAddr)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var include []string
+		if raw := strings.TrimSpace(r.URL.Query().Get("include")); len(raw) > 0 {
+			include = strings.Split(raw, ",")
+		}
+
+		params := querier.NewQueryDelegatorSummaryParams(delegatorAddr, include)
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData("custom/stake/delegator", bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
 }
 
 // HTTP request handler to query a delegator delegations
 func delegatorDelegationsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
-	return queryDelegator(cliCtx, cdc, "custom/stake/delegatorDelegations")
+	return queryDelegatorPaginated(cliCtx, cdc, "custom/stake/delegatorDelegations")
 }
 
 // HTTP request handler to query a delegator unbonding delegations
 func delegatorUnbondingDelegationsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
-	return queryDelegator(cliCtx, cdc, "custom/stake/delegatorUnbondingDelegations")
+	return queryDelegatorPaginated(cliCtx, cdc, "custom/stake/delegatorUnbondingDelegations")
 }
 
 // HTTP request handler to query a delegator redelegations
 func delegatorRedelegationsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
-	return queryDelegator(cliCtx, cdc, "custom/stake/delegatorRedelegations")
+	return queryDelegatorPaginated(cliCtx, cdc, "custom/stake/delegatorRedelegations")
+}
+
+// HTTP request handler to query all delegator bonded validators, filtered
+// by ?status= and sorted by ?sort= before paging.
+func delegatorValidatorsPaginatedHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bech32delegatorAddr := vars["delegatorAddr"]
+
+		delegatorAddr, err := sdk.AccAddressFromBech32(bech32delegatorAddr)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params, err := paginationParamsFromRequest(r)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		queryParams := types.NewQueryDelegatorParams(delegatorAddr)
+		bz, err := cdc.MarshalJSON(queryParams)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(endpoint, bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		var validators []types.Validator
+		if err := cdc.UnmarshalJSON(res, &validators); err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		validators = querier.FilterAndSortValidators(validators, params)
+
+		total := len(validators)
+		start, end := params.SliceBounds(total)
+		page := querier.NewPaginatedResult(validators[start:end], total, params)
+
+		out, err := cdc.MarshalJSON(page)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		utils.PostProcessResponse(w, cdc, out, cliCtx.Indent)
+	}
 }
 
 // HTTP request handler to query all staking txs (msgs) from a delegator
@@ -138,6 +248,12 @@ func delegatorTxsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.Han
 			return
 		}
 
+		params, err := paginationParamsFromRequest(r)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		// Get values from query
 
 		typesQuery := r.URL.Query().Get("type")
@@ -178,7 +294,11 @@ func delegatorTxsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.Han
 			txs = append(txs, foundTxs...)
 		}
 
-		res, err := cdc.MarshalJSON(txs)
+		total := len(txs)
+		start, end := params.SliceBounds(total)
+		page := querier.NewPaginatedResult(txs[start:end], total, params)
+
+		res, err := cdc.MarshalJSON(page)
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -199,7 +319,7 @@ func delegationHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.Handl
 
 // HTTP request handler to query all delegator bonded validators
 func delegatorValidatorsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
-	return queryDelegator(cliCtx, cdc, "custom/stake/delegatorValidators")
+	return delegatorValidatorsPaginatedHandlerFn(cliCtx, cdc, "custom/stake/delegatorValidators")
 }
 
 // HTTP request handler to get information from a currently bonded validator
@@ -207,15 +327,39 @@ func delegatorValidatorHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) ht
 	return queryBonds(cliCtx, cdc, "custom/stake/delegatorValidator")
 }
 
-// HTTP request handler to query list of validators
+// HTTP request handler to query list of validators, filtered by ?status=
+// and sorted by ?sort= before paging.
 func validatorsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		params, err := paginationParamsFromRequest(r)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		res, err := cliCtx.QueryWithData("custom/stake/validators", nil)
 		if err != nil {
 			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
-		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+
+		var validators []types.Validator
+		if err := cdc.UnmarshalJSON(res, &validators); err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		validators = querier.FilterAndSortValidators(validators, params)
+
+		total := len(validators)
+		start, end := params.SliceBounds(total)
+		page := querier.NewPaginatedResult(validators[start:end], total, params)
+
+		out, err := cdc.MarshalJSON(page)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		utils.PostProcessResponse(w, cdc, out, cliCtx.Indent)
 	}
 }
 
@@ -226,12 +370,12 @@ func validatorHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.Handle
 
 // HTTP request handler to query all unbonding delegations from a validator
 func validatorUnbondingDelegationsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
-	return queryValidator(cliCtx, cdc, "custom/stake/validatorUnbondingDelegations")
+	return queryValidatorPaginated(cliCtx, cdc, "custom/stake/validatorUnbondingDelegations")
 }
 
 // HTTP request handler to query all redelegations from a source validator
 func validatorRedelegationsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
-	return queryValidator(cliCtx, cdc, "custom/stake/validatorRedelegations")
+	return queryValidatorPaginated(cliCtx, cdc, "custom/stake/validatorRedelegations")
 }
 
 // HTTP request handler to query the pool information
@@ -257,3 +401,170 @@ func paramsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFu
 		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
 	}
 }
+
+// queryDelegatorPaginated queries endpoint for a single delegator address
+// and wraps the resulting array in the {items,total,page,limit} envelope
+// for the requested page.
+func queryDelegatorPaginated(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bech32delegatorAddr := vars["delegatorAddr"]
+
+		delegatorAddr, err := sdk.AccAddressFromBech32(bech32delegatorAddr)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		pagination, err := paginationParamsFromRequest(r)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := types.NewQueryDelegatorParams(delegatorAddr)
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(endpoint, bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		page, err := querier.PaginateRaw(cdc, res, pagination)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.PostProcessResponse(w, cdc, page, cliCtx.Indent)
+	}
+}
+
+// queryValidatorPaginated queries endpoint for a single validator address
+// and wraps the resulting array in the {items,total,page,limit} envelope
+// for the requested page.
+func queryValidatorPaginated(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bech32validatorAddr := vars["validatorAddr"]
+
+		validatorAddr, err := sdk.ValAddressFromBech32(bech32validatorAddr)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		pagination, err := paginationParamsFromRequest(r)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := types.NewQueryValidatorParams(validatorAddr)
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(endpoint, bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		page, err := querier.PaginateRaw(cdc, res, pagination)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.PostProcessResponse(w, cdc, page, cliCtx.Indent)
+	}
+}
+
+// queryBonds builds a query against endpoint for a delegator/validator pair,
+// used by the single-item (non-paginated) delegation lookups.
+func queryBonds(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bech32delegatorAddr := vars["delegatorAddr"]
+		bech32validatorAddr := vars["validatorAddr"]
+
+		delegatorAddr, err := sdk.AccAddressFromBech32(bech32delegatorAddr)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		validatorAddr, err := sdk.ValAddressFromBech32(bech32validatorAddr)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := types.NewQueryBondsParams(delegatorAddr, validatorAddr)
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(endpoint, bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+// queryValidator builds a query against endpoint for a single validator
+// address, used by the single-item (non-paginated) validator lookup.
+func queryValidator(cliCtx context.CLIContext, cdc *codec.Codec, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bech32validatorAddr := vars["validatorAddr"]
+
+		validatorAddr, err := sdk.ValAddressFromBech32(bech32validatorAddr)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := types.NewQueryValidatorParams(validatorAddr)
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(endpoint, bz)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+// contains reports whether slice contains item.
+func contains(slice []string, item string) bool {
+	for _, s := range slice {
+		if s == item {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,193 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/rest"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+
+	"github.com/gorilla/mux"
+)
+
+// registerTxRoutes registers the staking tx REST routes. The delegate,
+// unbond and redelegate handlers honor the `generate-only` and `simulate`
+// fields on the request's base_req (as well as its `fees`/`gas-prices`);
+// the estimate handler reads only the gas adjustment. So a cold-wallet client
+// can fetch back an unsigned StdTx, sign it offline with `gaiacli tx sign`,
+// and broadcast it separately via `POST /tx/broadcast`.
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	r.HandleFunc(
+		"/stake/delegators/{delegatorAddr}/delegations",
+		postDelegationsHandlerFn(cliCtx, cdc),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/stake/delegators/{delegatorAddr}/delegations/estimate",
+		estimateDelegationGasHandlerFn(cliCtx, cdc),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/stake/delegators/{delegatorAddr}/unbonding_delegations",
+		postUnbondingDelegationsHandlerFn(cliCtx, cdc),
+	).Methods("POST")
+
+	r.HandleFunc(
+		"/stake/delegators/{delegatorAddr}/redelegations",
+		postRedelegationsHandlerFn(cliCtx, cdc),
+	).Methods("POST")
+}
+
+// DelegateReq is the request body for POST .../delegations. BaseReq carries
+// the offline-signing knobs: `generate_only`, `simulate`, `fees` and
+// `gas_prices`.
+type DelegateReq struct {
+	BaseReq       rest.BaseReq   `json:"base_req"`
+	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+	ValidatorAddr sdk.ValAddress `json:"validator_addr"`
+	Delegation    sdk.Coin       `json:"delegation"`
+}
+
+func postDelegationsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req DelegateReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := stake.NewMsgDelegate(req.DelegatorAddr, req.ValidatorAddr, req.Delegation)
+		if err := msg.ValidateBasic(); err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.CompleteAndBroadcastTxREST(w, r, cliCtx, baseReq, []sdk.Msg{msg}, cdc)
+	}
+}
+
+// BeginUnbondingReq is the request body for POST .../unbonding_delegations.
+type BeginUnbondingReq struct {
+	BaseReq       rest.BaseReq   `json:"base_req"`
+	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+	ValidatorAddr sdk.ValAddress `json:"validator_addr"`
+	SharesAmount  sdk.Dec        `json:"shares"`
+}
+
+func postUnbondingDelegationsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req BeginUnbondingReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := stake.NewMsgBeginUnbonding(req.DelegatorAddr, req.ValidatorAddr, req.SharesAmount)
+		if err := msg.ValidateBasic(); err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.CompleteAndBroadcastTxREST(w, r, cliCtx, baseReq, []sdk.Msg{msg}, cdc)
+	}
+}
+
+// BeginRedelegateReq is the request body for POST .../redelegations.
+type BeginRedelegateReq struct {
+	BaseReq          rest.BaseReq   `json:"base_req"`
+	DelegatorAddr    sdk.AccAddress `json:"delegator_addr"`
+	ValidatorSrcAddr sdk.ValAddress `json:"validator_src_addr"`
+	ValidatorDstAddr sdk.ValAddress `json:"validator_dst_addr"`
+	SharesAmount     sdk.Dec        `json:"shares"`
+}
+
+func postRedelegationsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req BeginRedelegateReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := stake.NewMsgBeginRedelegate(req.DelegatorAddr, req.ValidatorSrcAddr, req.ValidatorDstAddr, req.SharesAmount)
+		if err := msg.ValidateBasic(); err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.CompleteAndBroadcastTxREST(w, r, cliCtx, baseReq, []sdk.Msg{msg}, cdc)
+	}
+}
+
+// EstimateDelegationGasReq is the request body for
+// POST .../delegations/estimate. It carries the same fields as DelegateReq
+// so the exact message that would be broadcast gets simulated.
+type EstimateDelegationGasReq struct {
+	BaseReq       rest.BaseReq   `json:"base_req"`
+	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+	ValidatorAddr sdk.ValAddress `json:"validator_addr"`
+	Delegation    sdk.Coin       `json:"delegation"`
+}
+
+// EstimateDelegationGasResponse is the gas estimate returned by the
+// estimate endpoint.
+type EstimateDelegationGasResponse struct {
+	GasEstimate uint64 `json:"gas_estimate"`
+}
+
+// estimateDelegationGasHandlerFn runs a MsgDelegate through
+// baseapp.Simulate (via cliCtx.BuildTxForSim and utils.CalculateGas) and
+// returns the estimated gas, without broadcasting anything.
+func estimateDelegationGasHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req EstimateDelegationGasReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := stake.NewMsgDelegate(req.DelegatorAddr, req.ValidatorAddr, req.Delegation)
+		if err := msg.ValidateBasic(); err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		txBytes, err := cliCtx.BuildTxForSim([]sdk.Msg{msg})
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		_, adjusted, err := utils.CalculateGas(cliCtx.QueryWithData, cdc, txBytes, baseReq.GasAdjustment)
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, err := cdc.MarshalJSON(EstimateDelegationGasResponse{GasEstimate: adjusted})
+		if err != nil {
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		utils.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
@@ -0,0 +1,85 @@
+package querier
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/stake/keeper"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+// maxDelegatorSummaryRetrieve bounds each of the per-validator lists folded
+// into a DelegatorSummary, mirroring the limits the individual delegator
+// queriers already apply.
+const maxDelegatorSummaryRetrieve = 100
+
+// DelegatorSummary is the response of the `custom/stake/delegator` querier:
+// a single, aggregated portfolio view of everything a delegator has staked,
+// so wallets and explorers don't need 4-5 separate LCD round trips.
+type DelegatorSummary struct {
+	Delegations          []types.Delegation          `json:"delegations"`
+	UnbondingDelegations []types.UnbondingDelegation `json:"unbonding_delegations"`
+	Redelegations        []types.Redelegation        `json:"redelegations"`
+	Validators           []types.Validator           `json:"validators"`
+	BondedTokens         sdk.Dec                     `json:"bonded_tokens"`
+	UnbondingTokens      sdk.Dec                     `json:"unbonding_tokens"`
+	AvailableTokens      sdk.Dec                     `json:"available_tokens"`
+}
+
+// QueryDelegatorSummaryParams is the request payload for
+// `custom/stake/delegator`. Include lists the optional sections the caller
+// asked for, e.g. ["rewards", "commission"]; it is accepted, but
+// QueryDelegatorSummary does not read it and adds no such section.
+type QueryDelegatorSummaryParams struct {
+	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+	Include       []string       `json:"include,omitempty"`
+}
+
+// NewQueryDelegatorSummaryParams builds a QueryDelegatorSummaryParams for
+// delegatorAddr, recording which optional sections the caller asked for.
+func NewQueryDelegatorSummaryParams(delegatorAddr sdk.AccAddress, include []string) QueryDelegatorSummaryParams {
+	return QueryDelegatorSummaryParams{
+		DelegatorAddr: delegatorAddr,
+		Include:       include,
+	}
+}
+
+// QueryDelegatorSummary composes the existing per-aspect delegator queries
+// into a single DelegatorSummary.
+func QueryDelegatorSummary(ctx sdk.Context, k keeper.Keeper, ak auth.AccountKeeper, params QueryDelegatorSummaryParams) DelegatorSummary {
+	delegations := k.GetDelegatorDelegations(ctx, params.DelegatorAddr, maxDelegatorSummaryRetrieve)
+	unbondingDelegations := k.GetUnbondingDelegations(ctx, params.DelegatorAddr, maxDelegatorSummaryRetrieve)
+	redelegations := k.GetRedelegations(ctx, params.DelegatorAddr, maxDelegatorSummaryRetrieve)
+	validators := k.GetDelegatorValidators(ctx, params.DelegatorAddr, maxDelegatorSummaryRetrieve)
+
+	denom := k.GetParams(ctx).BondDenom
+
+	bonded := sdk.ZeroDec()
+	for _, d := range delegations {
+		validator, found := k.GetValidator(ctx, d.ValidatorAddr)
+		if found {
+			bonded = bonded.Add(validator.DelegatorShareExRate().Mul(d.Shares))
+		}
+	}
+
+	unbonding := sdk.ZeroDec()
+	for _, ubd := range unbondingDelegations {
+		for _, entry := range ubd.Entries {
+			unbonding = unbonding.Add(sdk.NewDecFromInt(entry.Balance))
+		}
+	}
+
+	available := sdk.ZeroDec()
+	if acc := ak.GetAccount(ctx, params.DelegatorAddr); acc != nil {
+		available = sdk.NewDec(acc.GetCoins().AmountOf(denom))
+	}
+
+	return DelegatorSummary{
+		Delegations:          delegations,
+		UnbondingDelegations: unbondingDelegations,
+		Redelegations:        redelegations,
+		Validators:           validators,
+		BondedTokens:         bonded,
+		UnbondingTokens:      unbonding,
+		AvailableTokens:      available,
+	}
+}
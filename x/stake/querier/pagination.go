@@ -0,0 +1,117 @@
+package querier
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+const (
+	// DefaultPage is the page returned when the caller omits ?page=.
+	DefaultPage = 1
+	// DefaultLimit is the page size used when the caller omits ?limit=.
+	DefaultLimit = 100
+)
+
+// QueryPaginationParams carries the paging, status-filtering and sorting
+// options accepted by the stake module's list queriers (validators,
+// delegations, unbonding delegations, redelegations, ...). The LCD REST
+// handlers apply it to the full array returned by a `custom/stake/*` list
+// query; it is not sent to the queriers, which still return every item.
+type QueryPaginationParams struct {
+	Page   int    `json:"page"`
+	Limit  int    `json:"limit"`
+	Status string `json:"status"`
+	Sort   string `json:"sort"`
+}
+
+// NewQueryPaginationParams returns a QueryPaginationParams with out-of-range
+// page/limit values replaced by their defaults.
+func NewQueryPaginationParams(page, limit int, status, sort string) QueryPaginationParams {
+	if page <= 0 {
+		page = DefaultPage
+	}
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	return QueryPaginationParams{
+		Page:   page,
+		Limit:  limit,
+		Status: status,
+		Sort:   sort,
+	}
+}
+
+// ParseQueryPaginationParams builds a QueryPaginationParams from the raw,
+// string-typed query values taken off an http.Request.
+func ParseQueryPaginationParams(rawPage, rawLimit, status, sort string) (QueryPaginationParams, error) {
+	page := DefaultPage
+	limit := DefaultLimit
+
+	if len(rawPage) > 0 {
+		p, err := strconv.Atoi(rawPage)
+		if err != nil {
+			return QueryPaginationParams{}, err
+		}
+		page = p
+	}
+
+	if len(rawLimit) > 0 {
+		l, err := strconv.Atoi(rawLimit)
+		if err != nil {
+			return QueryPaginationParams{}, err
+		}
+		limit = l
+	}
+
+	return NewQueryPaginationParams(page, limit, status, sort), nil
+}
+
+// SliceBounds returns the [start, end) indices of the requested page within
+// a result set of size total, clamped to a valid range.
+func (p QueryPaginationParams) SliceBounds(total int) (start, end int) {
+	start = (p.Page - 1) * p.Limit
+	if start > total {
+		start = total
+	}
+	end = start + p.Limit
+	if end > total {
+		end = total
+	}
+	return start, end
+}
+
+// PaginatedResult is the JSON envelope returned by the paginated stake LCD
+// endpoints in place of a bare array.
+type PaginatedResult struct {
+	Items interface{} `json:"items"`
+	Total int         `json:"total"`
+	Page  int         `json:"page"`
+	Limit int         `json:"limit"`
+}
+
+// NewPaginatedResult wraps items with the bookkeeping fields describing the
+// page that was served.
+func NewPaginatedResult(items interface{}, total int, params QueryPaginationParams) PaginatedResult {
+	return PaginatedResult{
+		Items: items,
+		Total: total,
+		Page:  params.Page,
+		Limit: params.Limit,
+	}
+}
+
+// PaginateRaw decodes res as a JSON array, slices out the requested page,
+// and re-marshals it as a PaginatedResult envelope. It is used by REST
+// handlers whose backing querier still returns a bare, unpaginated array.
+func PaginateRaw(cdc *codec.Codec, res []byte, params QueryPaginationParams) ([]byte, error) {
+	var items []json.RawMessage
+	if err := cdc.UnmarshalJSON(res, &items); err != nil {
+		return nil, err
+	}
+
+	total := len(items)
+	start, end := params.SliceBounds(total)
+	return cdc.MarshalJSON(NewPaginatedResult(items[start:end], total, params))
+}
@@ -0,0 +1,42 @@
+package querier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryPaginationParamsSliceBounds(t *testing.T) {
+	cases := []struct {
+		name        string
+		page, limit int
+		total       int
+		start, end  int
+	}{
+		{"first page, room to spare", 1, 10, 25, 0, 10},
+		{"middle page", 2, 10, 25, 10, 20},
+		{"last, partial page", 3, 10, 25, 20, 25},
+		{"page past the end", 4, 10, 25, 25, 25},
+		{"empty result set", 1, 10, 0, 0, 0},
+		{"limit larger than total", 1, 100, 25, 0, 25},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := NewQueryPaginationParams(tc.page, tc.limit, "", "")
+			start, end := params.SliceBounds(tc.total)
+			require.Equal(t, tc.start, start, "start")
+			require.Equal(t, tc.end, end, "end")
+			require.True(t, start <= end, "start must never exceed end")
+			require.True(t, end <= tc.total, "end must never exceed total")
+		})
+	}
+}
+
+func TestNewQueryPaginationParamsDefaults(t *testing.T) {
+	params := NewQueryPaginationParams(0, -5, "bonded", "moniker")
+	require.Equal(t, DefaultPage, params.Page)
+	require.Equal(t, DefaultLimit, params.Limit)
+	require.Equal(t, "bonded", params.Status)
+	require.Equal(t, "moniker", params.Sort)
+}
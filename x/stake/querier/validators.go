@@ -0,0 +1,55 @@
+package querier
+
+import (
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/types"
+)
+
+// MatchesStatus reports whether validator should be kept for the given
+// ?status= filter (bonded/unbonded/unbonding); an empty filter matches
+// everything.
+func MatchesStatus(validator types.Validator, status string) bool {
+	if len(status) == 0 {
+		return true
+	}
+	return strings.EqualFold(sdk.BondStatusToString(validator.GetStatus()), status)
+}
+
+// SortValidators orders validators in place per the ?sort= value
+// (voting_power, moniker or bond_height); an unrecognized or empty value
+// leaves the iteration order untouched.
+func SortValidators(validators []types.Validator, by string) {
+	switch by {
+	case "voting_power":
+		sort.SliceStable(validators, func(i, j int) bool {
+			return validators[i].GetPower().GT(validators[j].GetPower())
+		})
+	case "moniker":
+		sort.SliceStable(validators, func(i, j int) bool {
+			return validators[i].Description.Moniker < validators[j].Description.Moniker
+		})
+	case "bond_height":
+		sort.SliceStable(validators, func(i, j int) bool {
+			return validators[i].BondHeight < validators[j].BondHeight
+		})
+	}
+}
+
+// FilterAndSortValidators applies MatchesStatus and SortValidators in one
+// call. It runs in the LCD process against the full validator set returned
+// by the `custom/stake/validators` query, since that query has no
+// status-aware, bounded-iteration form in this tree to push the filtering
+// down to the keeper.
+func FilterAndSortValidators(validators []types.Validator, params QueryPaginationParams) []types.Validator {
+	filtered := make([]types.Validator, 0, len(validators))
+	for _, v := range validators {
+		if MatchesStatus(v, params.Status) {
+			filtered = append(filtered, v)
+		}
+	}
+	SortValidators(filtered, params.Sort)
+	return filtered
+}
@@ -0,0 +1,109 @@
+package simulation
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+// invariant checks a single property of stake module state that must hold
+// after every simulated stake operation.
+type invariant func(ctx sdk.Context, m auth.AccountKeeper, k stake.Keeper) error
+
+// invariants is the full set of invariants asserted after each stake
+// simulation operation.
+var invariants = []invariant{
+	poolLooseTokensInvariant,
+	delegatorSharesInvariant,
+	nonNegativeSharesInvariant,
+}
+
+// assertInvariants runs every registered invariant, failing the simulation
+// with a diagnostic identifying the broken check and the action that
+// preceded it so the failure is reproducible from the simulation log.
+func assertInvariants(ctx sdk.Context, m auth.AccountKeeper, k stake.Keeper, action string, event func(string)) error {
+	for _, inv := range invariants {
+		if err := inv(ctx, m, k); err != nil {
+			return fmt.Errorf("invariant broken after %s: %v", action, err)
+		}
+	}
+	event(fmt.Sprintf("stake/invariants/ok: %s", action))
+	return nil
+}
+
+// poolLooseTokensInvariant checks that the pool's loose tokens equal the sum
+// of every account's balance of the bond denomination plus whatever is
+// sitting in outstanding unbonding delegations and redelegations. Those
+// leave the bonded pool (and so are already folded into LooseTokens) the
+// moment the unbond/redelegate message is processed, but aren't credited to
+// any account until CompleteUnbonding/CompleteRedelegation runs later, so
+// they'd otherwise look like missing tokens while they're in flight.
+func poolLooseTokensInvariant(ctx sdk.Context, m auth.AccountKeeper, k stake.Keeper) error {
+	pool := k.GetPool(ctx)
+	denom := k.GetParams(ctx).BondDenom
+
+	balances := sdk.ZeroDec()
+	m.IterateAccounts(ctx, func(acc sdk.Account) bool {
+		balances = balances.Add(sdk.NewDec(acc.GetCoins().AmountOf(denom)))
+		return false
+	})
+
+	for _, ubd := range k.GetAllUnbondingDelegations(ctx) {
+		for _, entry := range ubd.Entries {
+			balances = balances.Add(sdk.NewDecFromInt(entry.Balance))
+		}
+	}
+	for _, red := range k.GetAllRedelegations(ctx) {
+		for _, entry := range red.Entries {
+			balances = balances.Add(sdk.NewDecFromInt(entry.Balance))
+		}
+	}
+
+	if !pool.LooseTokens.Equal(balances) {
+		return fmt.Errorf(
+			"pool loose tokens (%s) do not match the sum of account balances plus pending unbonding/redelegation amounts (%s)",
+			pool.LooseTokens, balances,
+		)
+	}
+	return nil
+}
+
+// delegatorSharesInvariant checks that, for every validator, the sum of its
+// delegators' shares equals the validator's own DelegatorShares bookkeeping.
+func delegatorSharesInvariant(ctx sdk.Context, m auth.AccountKeeper, k stake.Keeper) error {
+	for _, validator := range k.GetAllValidators(ctx) {
+		sum := sdk.ZeroDec()
+		for _, delegation := range k.GetValidatorDelegations(ctx, validator.OperatorAddr) {
+			sum = sum.Add(delegation.Shares)
+		}
+
+		if !sum.Equal(validator.DelegatorShares) {
+			return fmt.Errorf(
+				"validator %s: sum of delegator shares (%s) does not match DelegatorShares (%s)",
+				validator.OperatorAddr, sum, validator.DelegatorShares,
+			)
+		}
+	}
+	return nil
+}
+
+// nonNegativeSharesInvariant checks that no delegation or validator carries
+// a negative share balance.
+func nonNegativeSharesInvariant(ctx sdk.Context, m auth.AccountKeeper, k stake.Keeper) error {
+	for _, validator := range k.GetAllValidators(ctx) {
+		if validator.DelegatorShares.IsNegative() {
+			return fmt.Errorf("validator %s has negative DelegatorShares: %s", validator.OperatorAddr, validator.DelegatorShares)
+		}
+		for _, delegation := range k.GetValidatorDelegations(ctx, validator.OperatorAddr) {
+			if delegation.Shares.IsNegative() {
+				return fmt.Errorf(
+					"delegation %s -> %s has negative shares: %s",
+					delegation.DelegatorAddr, delegation.ValidatorAddr, delegation.Shares,
+				)
+			}
+		}
+	}
+	return nil
+}
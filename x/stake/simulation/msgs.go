@@ -3,6 +3,7 @@ package simulation
 import (
 	"fmt"
 	"math/rand"
+	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -14,13 +15,96 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/stake"
 )
 
-// SimulateMsgCreateValidator
-func SimulateMsgCreateValidator(m auth.AccountKeeper, k stake.Keeper) simulation.Operation {
+// rollWeight reports whether an operation with the given weight (out of
+// 100) should fire this round. A non-positive weight never fires.
+func rollWeight(r *rand.Rand, weight int) bool {
+	if weight <= 0 {
+		return false
+	}
+	return r.Intn(100) < weight
+}
+
+// scheduleUnbondingCompletionCheck builds the FutureOperation run at
+// completionTime to assert a MsgBeginUnbonding has actually cleared. It
+// checks only that no matured entry is still stored for the pair; the
+// delegator's balance is not checked.
+func scheduleUnbondingCompletionCheck(
+	k stake.Keeper, delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress, completionTime time.Time,
+) simulation.FutureOperation {
+	return simulation.FutureOperation{
+		BlockTime: completionTime,
+		Op: func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+			accs []simulation.Account, event func(string)) (
+			action string, fOp []simulation.FutureOperation, err error) {
+
+			// A (delegator, validator) pair can accumulate more than one
+			// unbonding entry, e.g. simulation re-picking the same random
+			// accounts for a later MsgBeginUnbonding after this op ran.
+			// GetUnbondingDelegation returns found=true for the whole
+			// record, so checking mere presence would fail this op on an
+			// unrelated, still-maturing entry. Instead the op fails only if
+			// some entry of the pair is stale, i.e. its CompletionTime is no
+			// later than the current block time and it is still stored.
+			if ubd, found := k.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr); found {
+				for _, entry := range ubd.Entries {
+					if !entry.CompletionTime.After(ctx.BlockHeader().Time) {
+						return "", nil, fmt.Errorf(
+							"unbonding delegation %s -> %s had not completed by %s", delegatorAddr, validatorAddr, completionTime,
+						)
+					}
+				}
+			}
+
+			event("stake/FutureOperation/UnbondingCompleted")
+			action = fmt.Sprintf("checked unbonding completion for %s -> %s", delegatorAddr, validatorAddr)
+			return action, nil, nil
+		},
+	}
+}
+
+// scheduleRedelegationCompletionCheck builds the FutureOperation run at
+// completionTime to assert a MsgBeginRedelegate has actually cleared.
+func scheduleRedelegationCompletionCheck(
+	k stake.Keeper, delegatorAddr sdk.AccAddress, srcValidatorAddr, dstValidatorAddr sdk.ValAddress, completionTime time.Time,
+) simulation.FutureOperation {
+	return simulation.FutureOperation{
+		BlockTime: completionTime,
+		Op: func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+			accs []simulation.Account, event func(string)) (
+			action string, fOp []simulation.FutureOperation, err error) {
+
+			// Same reasoning as scheduleUnbondingCompletionCheck above: only
+			// fail if some matured entry of the (delegator, src, dst) key is
+			// still outstanding, not merely because the key has some entry.
+			if red, found := k.GetRedelegation(ctx, delegatorAddr, srcValidatorAddr, dstValidatorAddr); found {
+				for _, entry := range red.Entries {
+					if !entry.CompletionTime.After(ctx.BlockHeader().Time) {
+						return "", nil, fmt.Errorf(
+							"redelegation %s -> %s -> %s had not completed by %s",
+							delegatorAddr, srcValidatorAddr, dstValidatorAddr, completionTime,
+						)
+					}
+				}
+			}
+
+			event("stake/FutureOperation/RedelegationCompleted")
+			action = fmt.Sprintf("checked redelegation completion for %s -> %s -> %s", delegatorAddr, srcValidatorAddr, dstValidatorAddr)
+			return action, nil, nil
+		},
+	}
+}
+
+// SimulateMsgCreateValidator fires with probability params.WeightCreateValidator/100.
+func SimulateMsgCreateValidator(m auth.AccountKeeper, k stake.Keeper, params Params) simulation.Operation {
 	handler := stake.NewStakeHandler(k)
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 		accs []simulation.Account, event func(string)) (
 		action string, fOp []simulation.FutureOperation, err error) {
 
+		if !rollWeight(r, params.WeightCreateValidator) {
+			return "no-operation", nil, nil
+		}
+
 		denom := k.GetParams(ctx).BondDenom
 		description := stake.Description{
 			Moniker: simulation.RandStringOfLength(r, 10),
@@ -65,19 +149,29 @@ func SimulateMsgCreateValidator(m auth.AccountKeeper, k stake.Keeper) simulation
 
 		event(fmt.Sprintf("stake/MsgCreateValidator/%v", result.IsOK()))
 
+		if result.IsOK() {
+			if err := assertInvariants(ctx, m, k, "MsgCreateValidator", event); err != nil {
+				return "", nil, err
+			}
+		}
+
 		// require.True(t, result.IsOK(), "expected OK result but instead got %v", result)
 		action = fmt.Sprintf("TestMsgCreateValidator: ok %v, msg %s", result.IsOK(), msg.GetSignBytes())
 		return action, nil, nil
 	}
 }
 
-// SimulateMsgEditValidator
-func SimulateMsgEditValidator(k stake.Keeper) simulation.Operation {
+// SimulateMsgEditValidator fires with probability params.WeightEditValidator/100.
+func SimulateMsgEditValidator(m auth.AccountKeeper, k stake.Keeper, params Params) simulation.Operation {
 	handler := stake.NewStakeHandler(k)
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 		accs []simulation.Account, event func(string)) (
 		action string, fOp []simulation.FutureOperation, err error) {
 
+		if !rollWeight(r, params.WeightEditValidator) {
+			return "no-operation", nil, nil
+		}
+
 		description := stake.Description{
 			Moniker:  simulation.RandStringOfLength(r, 10),
 			Identity: simulation.RandStringOfLength(r, 10),
@@ -106,18 +200,29 @@ func SimulateMsgEditValidator(k stake.Keeper) simulation.Operation {
 			write()
 		}
 		event(fmt.Sprintf("stake/MsgEditValidator/%v", result.IsOK()))
+
+		if result.IsOK() {
+			if err := assertInvariants(ctx, m, k, "MsgEditValidator", event); err != nil {
+				return "", nil, err
+			}
+		}
+
 		action = fmt.Sprintf("TestMsgEditValidator: ok %v, msg %s", result.IsOK(), msg.GetSignBytes())
 		return action, nil, nil
 	}
 }
 
-// SimulateMsgDelegate
-func SimulateMsgDelegate(m auth.AccountKeeper, k stake.Keeper) simulation.Operation {
+// SimulateMsgDelegate fires with probability params.WeightDelegate/100.
+func SimulateMsgDelegate(m auth.AccountKeeper, k stake.Keeper, params Params) simulation.Operation {
 	handler := stake.NewStakeHandler(k)
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 		accs []simulation.Account, event func(string)) (
 		action string, fOp []simulation.FutureOperation, err error) {
 
+		if !rollWeight(r, params.WeightDelegate) {
+			return "no-operation", nil, nil
+		}
+
 		denom := k.GetParams(ctx).BondDenom
 		validatorAcc := simulation.RandomAcc(r, accs)
 		validatorAddress := sdk.ValAddress(validatorAcc.Address)
@@ -144,18 +249,31 @@ func SimulateMsgDelegate(m auth.AccountKeeper, k stake.Keeper) simulation.Operat
 			write()
 		}
 		event(fmt.Sprintf("stake/MsgDelegate/%v", result.IsOK()))
+
+		if result.IsOK() {
+			if err := assertInvariants(ctx, m, k, "MsgDelegate", event); err != nil {
+				return "", nil, err
+			}
+		}
+
 		action = fmt.Sprintf("TestMsgDelegate: ok %v, msg %s", result.IsOK(), msg.GetSignBytes())
 		return action, nil, nil
 	}
 }
 
-// SimulateMsgBeginUnbonding
-func SimulateMsgBeginUnbonding(m auth.AccountKeeper, k stake.Keeper) simulation.Operation {
+// SimulateMsgBeginUnbonding fires with probability params.WeightBeginUnbonding/100
+// and schedules a FutureOperation that asserts the unbonding delegation has
+// cleared by the time params.UnbondingTime has elapsed.
+func SimulateMsgBeginUnbonding(m auth.AccountKeeper, k stake.Keeper, params Params) simulation.Operation {
 	handler := stake.NewStakeHandler(k)
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 		accs []simulation.Account, event func(string)) (
 		action string, fOp []simulation.FutureOperation, err error) {
 
+		if !rollWeight(r, params.WeightBeginUnbonding) {
+			return "no-operation", nil, nil
+		}
+
 		denom := k.GetParams(ctx).BondDenom
 		validatorAcc := simulation.RandomAcc(r, accs)
 		validatorAddress := sdk.ValAddress(validatorAcc.Address)
@@ -182,18 +300,35 @@ func SimulateMsgBeginUnbonding(m auth.AccountKeeper, k stake.Keeper) simulation.
 			write()
 		}
 		event(fmt.Sprintf("stake/MsgBeginUnbonding/%v", result.IsOK()))
+
+		if result.IsOK() {
+			if err := assertInvariants(ctx, m, k, "MsgBeginUnbonding", event); err != nil {
+				return "", nil, err
+			}
+			completionTime := ctx.BlockHeader().Time.Add(params.UnbondingTime)
+			fOp = []simulation.FutureOperation{
+				scheduleUnbondingCompletionCheck(k, delegatorAddress, validatorAddress, completionTime),
+			}
+		}
+
 		action = fmt.Sprintf("TestMsgBeginUnbonding: ok %v, msg %s", result.IsOK(), msg.GetSignBytes())
-		return action, nil, nil
+		return action, fOp, nil
 	}
 }
 
-// SimulateMsgBeginRedelegate
-func SimulateMsgBeginRedelegate(m auth.AccountKeeper, k stake.Keeper) simulation.Operation {
+// SimulateMsgBeginRedelegate fires with probability params.WeightBeginRedelegate/100
+// and schedules a FutureOperation that asserts the redelegation has
+// completed by the time params.UnbondingTime has elapsed.
+func SimulateMsgBeginRedelegate(m auth.AccountKeeper, k stake.Keeper, params Params) simulation.Operation {
 	handler := stake.NewStakeHandler(k)
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 		accs []simulation.Account, event func(string)) (
 		action string, fOp []simulation.FutureOperation, err error) {
 
+		if !rollWeight(r, params.WeightBeginRedelegate) {
+			return "no-operation", nil, nil
+		}
+
 		denom := k.GetParams(ctx).BondDenom
 		sourceValidatorAcc := simulation.RandomAcc(r, accs)
 		sourceValidatorAddress := sdk.ValAddress(sourceValidatorAcc.Address)
@@ -224,8 +359,19 @@ func SimulateMsgBeginRedelegate(m auth.AccountKeeper, k stake.Keeper) simulation
 			write()
 		}
 		event(fmt.Sprintf("stake/MsgBeginRedelegate/%v", result.IsOK()))
+
+		if result.IsOK() {
+			if err := assertInvariants(ctx, m, k, "MsgBeginRedelegate", event); err != nil {
+				return "", nil, err
+			}
+			completionTime := ctx.BlockHeader().Time.Add(params.UnbondingTime)
+			fOp = []simulation.FutureOperation{
+				scheduleRedelegationCompletionCheck(k, delegatorAddress, sourceValidatorAddress, destValidatorAddress, completionTime),
+			}
+		}
+
 		action = fmt.Sprintf("TestMsgBeginRedelegate: %s", msg.GetSignBytes())
-		return action, nil, nil
+		return action, fOp, nil
 	}
 }
 
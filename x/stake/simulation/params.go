@@ -0,0 +1,55 @@
+package simulation
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"time"
+)
+
+// Params are the per-operation weights and timing knobs the stake
+// simulation operations are driven by. They are normally loaded from a
+// params.json file passed to the simulation binary; DefaultParams is used
+// when no file is supplied.
+type Params struct {
+	WeightCreateValidator int           `json:"weight_create_validator"`
+	WeightEditValidator   int           `json:"weight_edit_validator"`
+	WeightDelegate        int           `json:"weight_delegate"`
+	WeightBeginUnbonding  int           `json:"weight_begin_unbonding"`
+	WeightBeginRedelegate int           `json:"weight_begin_redelegate"`
+	UnbondingTime         time.Duration `json:"unbonding_time"`
+}
+
+// DefaultParams returns the weights used when the simulation is run without
+// a params.json file.
+func DefaultParams() Params {
+	return Params{
+		WeightCreateValidator: 5,
+		WeightEditValidator:   5,
+		WeightDelegate:        100,
+		WeightBeginUnbonding:  65,
+		WeightBeginRedelegate: 35,
+		UnbondingTime:         3 * 24 * time.Hour,
+	}
+}
+
+// LoadParams reads simulation op weights from the params.json file at path.
+// It falls back to DefaultParams when path is empty or the file cannot be
+// read or parsed, so callers can always pass the result straight to the
+// Simulate* operations.
+func LoadParams(path string) Params {
+	params := DefaultParams()
+	if len(path) == 0 {
+		return params
+	}
+
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return params
+	}
+
+	if err := json.Unmarshal(bz, &params); err != nil {
+		return DefaultParams()
+	}
+
+	return params
+}